@@ -0,0 +1,99 @@
+package interp
+
+// A BicubicSpline interpolates a 2-D scalar field
+// given on a rectilinear grid, by composing 1-D
+// CubicSplines: one across each row of the grid, then
+// one more down the column of per-row results.
+type BicubicSpline struct {
+	style      SplineStyle
+	xs         []float64
+	ys         []float64
+	z          [][]float64
+	rowSplines []*CubicSpline
+}
+
+// NewBicubicSpline creates a BicubicSpline over a
+// rectilinear grid, where z[i][j] is the surface value
+// at (xs[i], ys[j]).
+//
+// len(z) must equal len(xs), and each len(z[i]) must
+// equal len(ys). The row splines are built once here,
+// since they depend only on the grid and not on any
+// query point.
+func NewBicubicSpline(xs, ys []float64, z [][]float64, style SplineStyle) *BicubicSpline {
+	b := &BicubicSpline{style: style, xs: xs, ys: ys, z: z}
+	b.rowSplines = make([]*CubicSpline, len(ys))
+	for j := range ys {
+		spline := NewCubicSpline(style)
+		for i, x := range xs {
+			spline.Add(x, z[i][j])
+		}
+		b.rowSplines[j] = spline
+	}
+	return b
+}
+
+// Eval evaluates the surface at (x, y).
+func (b *BicubicSpline) Eval(x, y float64) float64 {
+	return b.columnSpline(b.rowValues(x)).Eval(y)
+}
+
+// DerivX evaluates the partial derivative of the
+// surface with respect to x, at (x, y).
+func (b *BicubicSpline) DerivX(x, y float64) float64 {
+	return b.columnSpline(b.rowDerivs(x)).Eval(y)
+}
+
+// DerivY evaluates the partial derivative of the
+// surface with respect to y, at (x, y).
+func (b *BicubicSpline) DerivY(x, y float64) float64 {
+	return b.columnSpline(b.rowValues(x)).Deriv(y)
+}
+
+// DerivXY evaluates the mixed partial derivative
+// d^2/(dx dy) of the surface, at (x, y).
+func (b *BicubicSpline) DerivXY(x, y float64) float64 {
+	return b.columnSpline(b.rowDerivs(x)).Deriv(y)
+}
+
+// Integ evaluates the definite double integral of the
+// surface over [x1, x2] x [y1, y2], computed as an
+// iterated integral over the tensor-product cubic
+// pieces.
+func (b *BicubicSpline) Integ(x1, y1, x2, y2 float64) float64 {
+	values := make([]float64, len(b.ys))
+	for j, spline := range b.rowSplines {
+		values[j] = spline.Integ(x1, x2)
+	}
+	return b.columnSpline(values).Integ(y1, y2)
+}
+
+// rowValues evaluates every row spline at x, producing
+// one value per entry in ys.
+func (b *BicubicSpline) rowValues(x float64) []float64 {
+	values := make([]float64, len(b.ys))
+	for j, spline := range b.rowSplines {
+		values[j] = spline.Eval(x)
+	}
+	return values
+}
+
+// rowDerivs evaluates the x-derivative of every row
+// spline at x, producing one value per entry in ys.
+func (b *BicubicSpline) rowDerivs(x float64) []float64 {
+	values := make([]float64, len(b.ys))
+	for j, spline := range b.rowSplines {
+		values[j] = spline.Deriv(x)
+	}
+	return values
+}
+
+// columnSpline builds the spline across y from one
+// value per row.
+func (b *BicubicSpline) columnSpline(values []float64) *CubicSpline {
+	spline := NewCubicSpline(b.style)
+	for j, y := range b.ys {
+		spline.Add(y, values[j])
+	}
+	return spline
+}
@@ -0,0 +1,42 @@
+package interp
+
+import "testing"
+
+func TestBicubicSplinePlane(t *testing.T) {
+	// z = x + 2y, which any of the three styles should
+	// reproduce exactly since it is linear in x and y.
+	xs := []float64{0, 1, 2}
+	ys := []float64{0, 1, 2}
+	z := make([][]float64, len(xs))
+	for i, x := range xs {
+		z[i] = make([]float64, len(ys))
+		for j, y := range ys {
+			z[i][j] = x + 2*y
+		}
+	}
+
+	b := NewBicubicSpline(xs, ys, z, StandardStyle)
+
+	cases := []struct{ x, y float64 }{
+		{0, 0}, {1, 1}, {1.5, 0.5}, {2, 2},
+	}
+	for _, c := range cases {
+		got := b.Eval(c.x, c.y)
+		want := c.x + 2*c.y
+		if diff := got - want; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("Eval(%v, %v) = %v, want %v", c.x, c.y, got, want)
+		}
+	}
+
+	if got := b.DerivX(1, 1); got < 1-1e-9 || got > 1+1e-9 {
+		t.Errorf("DerivX = %v, want 1", got)
+	}
+	if got := b.DerivY(1, 1); got < 2-1e-9 || got > 2+1e-9 {
+		t.Errorf("DerivY = %v, want 2", got)
+	}
+
+	// Integral of x+2y over [0,1]x[0,1] is 1/2 + 1 = 3/2.
+	if got := b.Integ(0, 0, 1, 1); got < 1.5-1e-9 || got > 1.5+1e-9 {
+		t.Errorf("Integ = %v, want 1.5", got)
+	}
+}
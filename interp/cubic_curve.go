@@ -0,0 +1,150 @@
+package interp
+
+import (
+	"math"
+
+	"github.com/unixpickle/num-analysis/linalg"
+)
+
+// A CubicCurve2D is a parametric curve x(t), y(t)
+// where x and y are each cubic functions of t, valid
+// over the parameter range [T0, T1].
+//
+// This is useful for rendering or numerically
+// processing the output of spline interpolants: each
+// piece of a CubicSpline is a CubicFunc valid over its
+// own knot interval [x_i, x_{i+1}], which is exactly
+// the T0/T1 range a CubicCurve2D expects.
+type CubicCurve2D struct {
+	X      CubicFunc
+	Y      CubicFunc
+	T0, T1 float64
+}
+
+// NewCubicCurve2D creates a CubicCurve2D from a
+// CubicFunc pair that's valid over the parameter range
+// [t0, t1].
+func NewCubicCurve2D(x, y CubicFunc, t0, t1 float64) *CubicCurve2D {
+	return &CubicCurve2D{X: x, Y: y, T0: t0, T1: t1}
+}
+
+// Eval evaluates the curve at a parameter t,
+// returning the point (x(t), y(t)).
+func (c *CubicCurve2D) Eval(t float64) linalg.Vector {
+	return linalg.Vector{c.X.Eval(t), c.Y.Eval(t)}
+}
+
+// maxFlattenDepth bounds how many times Flatten will
+// subdivide a single segment, guarding against a
+// tolerance that is unreachable due to floating-point
+// precision.
+const maxFlattenDepth = 20
+
+// Flatten approximates the curve with a polyline
+// that stays within tolerance of the true curve,
+// using non-recursive De Casteljau subdivision.
+// A segment is subdivided at most maxFlattenDepth
+// times, so a tolerance finer than that depth can
+// reach is not met.
+//
+// The returned polyline always includes both
+// endpoints of the curve.
+func (c *CubicCurve2D) Flatten(tolerance float64) []linalg.Vector {
+	px0, px1, px2, px3 := bezierCoeffs(c.X, c.T0, c.T1)
+	py0, py1, py2, py3 := bezierCoeffs(c.Y, c.T0, c.T1)
+
+	type quad [4]linalg.Vector
+	type quadAtDepth struct {
+		quad  quad
+		depth int
+	}
+	start := quad{
+		linalg.Vector{px0, py0},
+		linalg.Vector{px1, py1},
+		linalg.Vector{px2, py2},
+		linalg.Vector{px3, py3},
+	}
+
+	result := []linalg.Vector{start[0]}
+
+	stack := []quadAtDepth{{quad: start}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		cur := top.quad
+
+		if top.depth >= maxFlattenDepth || isFlatEnough(cur, tolerance) {
+			result = append(result, cur[3])
+			continue
+		}
+
+		q1 := midpoint(cur[0], cur[1])
+		q2 := midpoint(cur[1], cur[2])
+		q3 := midpoint(cur[2], cur[3])
+		r0 := midpoint(q1, q2)
+		r1 := midpoint(q2, q3)
+		s0 := midpoint(r0, r1)
+
+		left := quad{cur[0], q1, r0, s0}
+		right := quad{s0, r1, q3, cur[3]}
+
+		// Push right first so left is processed next,
+		// keeping the output polyline in order.
+		stack = append(stack,
+			quadAtDepth{quad: right, depth: top.depth + 1},
+			quadAtDepth{quad: left, depth: top.depth + 1})
+	}
+
+	return result
+}
+
+func isFlatEnough(q [4]linalg.Vector, tolerance float64) bool {
+	chord := subVec(q[3], q[0])
+	chordLen := math.Sqrt(chord[0]*chord[0] + chord[1]*chord[1])
+
+	if chordLen == 0 {
+		d1 := subVec(q[1], q[0])
+		d2 := subVec(q[2], q[0])
+		return math.Hypot(d1[0], d1[1]) <= tolerance && math.Hypot(d2[0], d2[1]) <= tolerance
+	}
+
+	d1 := pointLineDistance(q[1], q[0], chord, chordLen)
+	d2 := pointLineDistance(q[2], q[0], chord, chordLen)
+	return d1 <= tolerance && d2 <= tolerance
+}
+
+func pointLineDistance(p, origin, chord linalg.Vector, chordLen float64) float64 {
+	rel := subVec(p, origin)
+	cross := chord[0]*rel[1] - chord[1]*rel[0]
+	return math.Abs(cross) / chordLen
+}
+
+func subVec(a, b linalg.Vector) linalg.Vector {
+	return linalg.Vector{a[0] - b[0], a[1] - b[1]}
+}
+
+func midpoint(a, b linalg.Vector) linalg.Vector {
+	return linalg.Vector{(a[0] + b[0]) / 2, (a[1] + b[1]) / 2}
+}
+
+// bezierCoeffs converts a power-basis cubic
+// a + b*t + c*t^2 + d*t^3, valid over t in [t0, t1],
+// into the four Bezier control point coordinates for
+// the reparameterized curve over s in [0, 1].
+func bezierCoeffs(f CubicFunc, t0, t1 float64) (p0, p1, p2, p3 float64) {
+	dt := t1 - t0
+
+	// Substitute t = t0 + dt*s into f and collect terms
+	// by power of s, so the curve is expressed in terms
+	// of s in [0, 1] before converting to Bezier form.
+	a := f[0] + f[1]*t0 + f[2]*t0*t0 + f[3]*t0*t0*t0
+	b := dt * (f[1] + 2*f[2]*t0 + 3*f[3]*t0*t0)
+	c := dt * dt * (f[2] + 3*f[3]*t0)
+	d := dt * dt * dt * f[3]
+
+	p0 = a
+	p1 = a + b/3
+	p2 = a + 2*b/3 + c/3
+	p3 = a + b + c + d
+	return
+}
@@ -0,0 +1,51 @@
+package interp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/unixpickle/num-analysis/linalg"
+)
+
+func TestCubicCurve2DFlattenDomain(t *testing.T) {
+	// x(t) = 1 + 2t, y(t) = 0, valid over t in [2, 5] --
+	// a domain that does not start at 0, mirroring a
+	// CubicFunc taken straight from a CubicSpline piece.
+	x := CubicFunc{1, 2, 0, 0}
+	y := CubicFunc{0, 0, 0, 0}
+	curve := NewCubicCurve2D(x, y, 2, 5)
+
+	poly := curve.Flatten(1e-6)
+	if len(poly) < 2 {
+		t.Fatalf("expected at least two points, got %d", len(poly))
+	}
+
+	start := curve.Eval(2)
+	end := curve.Eval(5)
+
+	if poly[0][0] != start[0] || poly[0][1] != start[1] {
+		t.Errorf("first point %v does not match curve.Eval(T0) %v", poly[0], start)
+	}
+	last := poly[len(poly)-1]
+	if last[0] != end[0] || last[1] != end[1] {
+		t.Errorf("last point %v does not match curve.Eval(T1) %v", last, end)
+	}
+}
+
+func TestCubicCurve2DFlattenUnreachableTolerance(t *testing.T) {
+	curve := NewCubicCurve2D(CubicFunc{0, 0, 1, 1}, CubicFunc{0, 1, 0, 1}, 0, 1)
+
+	done := make(chan []linalg.Vector, 1)
+	go func() {
+		done <- curve.Flatten(1e-20)
+	}()
+
+	select {
+	case poly := <-done:
+		if len(poly) < 2 {
+			t.Fatalf("expected at least two points, got %d", len(poly))
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Flatten did not return for an unreachable tolerance")
+	}
+}
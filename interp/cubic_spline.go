@@ -2,6 +2,7 @@ package interp
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/unixpickle/num-analysis/kahan"
@@ -230,8 +231,29 @@ func (c *CubicSpline) computeMidArcSlope(idx int) float64 {
 }
 
 func (c *CubicSpline) computeMonotoneSlope(idx int) float64 {
-	// TODO: this.
-	panic("monotone cubic splines not yet implemented.")
+	if len(c.x) < 2 {
+		return 0
+	}
+	if idx == 0 {
+		return (c.y[1] - c.y[0]) / (c.x[1] - c.x[0])
+	} else if last := len(c.x) - 1; idx == last {
+		return (c.y[last] - c.y[last-1]) / (c.x[last] - c.x[last-1])
+	}
+
+	delta0 := (c.y[idx] - c.y[idx-1]) / (c.x[idx] - c.x[idx-1])
+	delta1 := (c.y[idx+1] - c.y[idx]) / (c.x[idx+1] - c.x[idx])
+
+	if delta0 == 0 || delta1 == 0 || (delta0 > 0) != (delta1 > 0) {
+		return 0
+	}
+
+	slope := (delta0 + delta1) / 2
+	alpha := slope / delta0
+	beta := slope / delta1
+	if sqSum := alpha*alpha + beta*beta; sqSum > 9 {
+		slope *= 3 / math.Sqrt(sqSum)
+	}
+	return slope
 }
 
 func (c *CubicSpline) updateFunc(idx int) {
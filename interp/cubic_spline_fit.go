@@ -0,0 +1,190 @@
+package interp
+
+import (
+	"math"
+	"sort"
+
+	"github.com/unixpickle/num-analysis/kahan"
+	"github.com/unixpickle/num-analysis/linalg"
+	"github.com/unixpickle/num-analysis/linalg/ludecomp"
+)
+
+// FitCubicSpline fits a CubicSpline through a set of
+// user-supplied knots that approximates many noisy
+// (xs[i], ys[i]) samples, rather than interpolating
+// every sample exactly.
+//
+// Each sample is weighted by the corresponding entry
+// in weights. The knot values and slopes are found by
+// solving a weighted linear least-squares problem,
+// with C1 continuity at each interior knot enforced
+// automatically by construction.
+//
+// xs, ys and weights must have the same length.
+// knots need not be sorted.
+func FitCubicSpline(xs, ys, weights []float64, knots []float64, style SplineStyle) *CubicSpline {
+	if len(xs) != len(ys) || len(xs) != len(weights) {
+		panic("interp: xs, ys, and weights must have the same length")
+	}
+
+	sortedKnots := append([]float64{}, knots...)
+	sort.Float64s(sortedKnots)
+
+	if len(sortedKnots) == 0 {
+		return NewCubicSpline(style)
+	} else if len(sortedKnots) == 1 {
+		sum := kahan.NewSummer64()
+		weightSum := kahan.NewSummer64()
+		for i, w := range weights {
+			sum.Add(w * ys[i])
+			weightSum.Add(w)
+		}
+		spline := NewCubicSpline(style)
+		if weightSum.Sum() == 0 {
+			spline.Add(sortedKnots[0], 0)
+		} else {
+			spline.Add(sortedKnots[0], sum.Sum()/weightSum.Sum())
+		}
+		return spline
+	}
+
+	knotCount := len(sortedKnots)
+	unknowns := 2 * knotCount
+	ata := make([]float64, unknowns*unknowns)
+	atb := make([]float64, unknowns)
+
+	for i, x := range xs {
+		k := knotInterval(sortedKnots, x)
+		dx := sortedKnots[k+1] - sortedKnots[k]
+		t := (x - sortedKnots[k]) / dx
+
+		row := [4]float64{hermite00(t), hermite10(t) * dx, hermite01(t), hermite11(t) * dx}
+		idx := [4]int{2 * k, 2*k + 1, 2 * (k + 1), 2*(k+1) + 1}
+
+		w := weights[i]
+		for a := 0; a < 4; a++ {
+			atb[idx[a]] += w * row[a] * ys[i]
+			for b := 0; b < 4; b++ {
+				ata[idx[a]*unknowns+idx[b]] += w * row[a] * row[b]
+			}
+		}
+	}
+
+	system := &linalg.Matrix{Rows: unknowns, Cols: unknowns, Data: ata}
+	lu := ludecomp.Decompose(system)
+	solution := lu.Solve(linalg.Vector(atb))
+
+	spline := &CubicSpline{
+		style:  style,
+		x:      sortedKnots,
+		y:      make([]float64, knotCount),
+		slopes: make([]float64, knotCount),
+		funcs:  make([]CubicFunc, knotCount-1),
+	}
+	for k := 0; k < knotCount; k++ {
+		spline.y[k] = solution[2*k]
+		spline.slopes[k] = solution[2*k+1]
+	}
+	for k := 0; k < knotCount-1; k++ {
+		spline.updateFunc(k)
+	}
+
+	return spline
+}
+
+// FitCubicSplineIRLS is like FitCubicSpline, but it
+// takes no weights from the caller and instead derives
+// them iteratively: after each pass, samples with a
+// large residual are downweighted before the next
+// fit, making the result more robust to outliers in
+// noisy scattered data.
+func FitCubicSplineIRLS(xs, ys []float64, knots []float64, style SplineStyle,
+	iterations int) *CubicSpline {
+	weights := make([]float64, len(xs))
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	var spline *CubicSpline
+	for iter := 0; iter < iterations; iter++ {
+		spline = FitCubicSpline(xs, ys, weights, knots, style)
+
+		residuals := make([]float64, len(xs))
+		for i, x := range xs {
+			residuals[i] = ys[i] - spline.Eval(x)
+		}
+
+		scale := robustScale(residuals)
+		if scale == 0 {
+			break
+		}
+		for i, r := range residuals {
+			// Tukey biweight: samples more than ~4.685
+			// scales away from the fit are ignored entirely.
+			u := r / (4.685 * scale)
+			if math.Abs(u) >= 1 {
+				weights[i] = 0
+			} else {
+				weights[i] = (1 - u*u) * (1 - u*u)
+			}
+		}
+	}
+
+	if spline == nil {
+		return FitCubicSpline(xs, ys, weights, knots, style)
+	}
+	return spline
+}
+
+// robustScale estimates the spread of residuals using
+// the median absolute deviation, scaled to be a
+// consistent estimator of the standard deviation for
+// normally-distributed residuals.
+func robustScale(residuals []float64) float64 {
+	abs := make([]float64, len(residuals))
+	for i, r := range residuals {
+		abs[i] = math.Abs(r)
+	}
+	sort.Float64s(abs)
+	if len(abs) == 0 {
+		return 0
+	}
+	return median(abs) * 1.4826
+}
+
+func median(sorted []float64) float64 {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// knotInterval finds the index k such that x falls in
+// [knots[k], knots[k+1]], clamping to the first or
+// last interval if x is out of range.
+func knotInterval(knots []float64, x float64) int {
+	idx := sort.SearchFloat64s(knots, x) - 1
+	if idx < 0 {
+		idx = 0
+	} else if idx > len(knots)-2 {
+		idx = len(knots) - 2
+	}
+	return idx
+}
+
+func hermite00(t float64) float64 {
+	return 2*t*t*t - 3*t*t + 1
+}
+
+func hermite10(t float64) float64 {
+	return t*t*t - 2*t*t + t
+}
+
+func hermite01(t float64) float64 {
+	return -2*t*t*t + 3*t*t
+}
+
+func hermite11(t float64) float64 {
+	return t*t*t - t*t
+}
@@ -0,0 +1,48 @@
+package interp
+
+import "testing"
+
+func TestFitCubicSpline(t *testing.T) {
+	xs := []float64{0, 1, 2, 3, 4, 5}
+	ys := make([]float64, len(xs))
+	weights := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = x * x
+		weights[i] = 1
+	}
+
+	spline := FitCubicSpline(xs, ys, weights, []float64{0, 2.5, 5}, StandardStyle)
+	for _, x := range xs {
+		got := spline.Eval(x)
+		want := x * x
+		if diff := got - want; diff > 1 || diff < -1 {
+			t.Errorf("x=%v: got %v, want close to %v", x, got, want)
+		}
+	}
+}
+
+func TestFitCubicSplineSingleKnot(t *testing.T) {
+	xs := []float64{0, 1, 2}
+	ys := []float64{1, 2, 3}
+	weights := []float64{1, 1, 1}
+
+	spline := FitCubicSpline(xs, ys, weights, []float64{5}, StandardStyle)
+	if got := spline.Eval(5); got != 2 {
+		t.Errorf("expected weighted mean 2, got %v", got)
+	}
+}
+
+func TestFitCubicSplineIRLSDownweightsOutlier(t *testing.T) {
+	xs := make([]float64, 11)
+	ys := make([]float64, 11)
+	for i := range xs {
+		xs[i] = float64(i)
+		ys[i] = float64(i * i)
+	}
+	ys[5] = 1000 // outlier at x=5
+
+	spline := FitCubicSplineIRLS(xs, ys, []float64{0, 5, 10}, StandardStyle, 5)
+	if got := spline.Eval(5); got > 30 {
+		t.Errorf("outlier at x=5 not downweighted enough: got %v, want near 25", got)
+	}
+}
@@ -0,0 +1,54 @@
+package interp
+
+import "testing"
+
+func TestCubicSplineMonotoneStyle(t *testing.T) {
+	xs := []float64{0, 1, 2, 3}
+	ys := []float64{0, 1, 1, 0}
+
+	spline := NewCubicSpline(MonotoneStyle)
+	for i, x := range xs {
+		spline.Add(x, ys[i])
+	}
+
+	checkMonotone := func(x1, x2 float64, increasing bool) {
+		const samples = 50
+		last := spline.Eval(x1)
+		for i := 1; i <= samples; i++ {
+			x := x1 + (x2-x1)*float64(i)/samples
+			val := spline.Eval(x)
+			if increasing && val < last-1e-9 {
+				t.Errorf("non-monotone increase on [%f, %f]: %f then %f", x1, x2, last, val)
+			} else if !increasing && val > last+1e-9 {
+				t.Errorf("non-monotone decrease on [%f, %f]: %f then %f", x1, x2, last, val)
+			}
+			last = val
+		}
+	}
+
+	checkMonotone(0, 1, true)
+	checkMonotone(1, 2, true)
+	checkMonotone(2, 3, false)
+}
+
+func TestCubicSplineMonotoneOvershoot(t *testing.T) {
+	// A standard spline overshoots on this flat-then-equal segment,
+	// but the monotone style should stay within [1, 1] on [1, 2].
+	standard := NewCubicSpline(StandardStyle)
+	monotone := NewCubicSpline(MonotoneStyle)
+	xs := []float64{0, 1, 2, 3}
+	ys := []float64{0, 1, 1, 0}
+	for i, x := range xs {
+		standard.Add(x, ys[i])
+		monotone.Add(x, ys[i])
+	}
+
+	const samples = 50
+	for i := 0; i <= samples; i++ {
+		x := 1 + float64(i)/samples
+		val := monotone.Eval(x)
+		if val > 1+1e-9 || val < 1-1e-9 {
+			t.Errorf("monotone spline should stay flat at y=1 on [1, 2], got %f at x=%f", val, x)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package kahan
 
-// A Summer64 computes a rolling sum of float64s.
+// A Summer64 computes a rolling sum of float64s using
+// Neumaier's improved compensated summation, which
+// remains accurate even when an incoming term is larger
+// in magnitude than the running sum.
 type Summer64 struct {
 	sum          float64
 	compensation float64
@@ -13,16 +16,20 @@ func NewSummer64() *Summer64 {
 
 // Add adds a number to the current sum, returning the new sum.
 func (s *Summer64) Add(n float64) float64 {
-	n -= s.compensation
-	sum := s.sum + n
-	s.compensation = (sum - s.sum) - n
-	s.sum = sum
-	return s.sum
+	s.sum, s.compensation = neumaierAdd(s.sum, s.compensation, n)
+	return s.sum + s.compensation
+}
+
+// AddScaled adds alpha*x to the current sum, returning
+// the new sum.
+func (s *Summer64) AddScaled(alpha, x float64) float64 {
+	s.sum, s.compensation = neumaierAdd(s.sum, s.compensation, alpha*x)
+	return s.sum + s.compensation
 }
 
 // Sum returns the current sum.
 func (s *Summer64) Sum() float64 {
-	return s.sum
+	return s.sum + s.compensation
 }
 
 // Sum64 adds all the floats in a slice and returns the sum
@@ -34,6 +41,60 @@ func Sum64(nums []float64) float64 {
 	return summer.Sum()
 }
 
+// Dot64 computes the dot product of a and b using
+// Neumaier's improved compensated summation, so that
+// the result stays accurate even when terms of very
+// different magnitudes are summed together.
+//
+// Dot64 panics if a and b have different lengths.
+func Dot64(a, b []float64) float64 {
+	if len(a) != len(b) {
+		panic("kahan: mismatched vector lengths")
+	}
+	var sum, compensation float64
+	for i, x := range a {
+		sum, compensation = neumaierAdd(sum, compensation, x*b[i])
+	}
+	return sum + compensation
+}
+
+// AxpySum64 computes the sum of alpha*x[i]+y[i] over
+// all i, using Neumaier's improved compensated
+// summation.
+//
+// AxpySum64 panics if x and y have different lengths.
+func AxpySum64(alpha float64, x, y []float64) float64 {
+	if len(x) != len(y) {
+		panic("kahan: mismatched vector lengths")
+	}
+	var sum, compensation float64
+	for i, xi := range x {
+		sum, compensation = neumaierAdd(sum, compensation, alpha*xi+y[i])
+	}
+	return sum + compensation
+}
+
+// neumaierAdd adds term to sum, given the running
+// compensation, using Neumaier's improved version of
+// Kahan summation. It returns the updated sum and
+// compensation.
+func neumaierAdd(sum, compensation, term float64) (float64, float64) {
+	newSum := sum + term
+	if abs64(sum) >= abs64(term) {
+		compensation += (sum - newSum) + term
+	} else {
+		compensation += (term - newSum) + sum
+	}
+	return newSum, compensation
+}
+
+func abs64(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
 // A ComplexSummer128 computes a rolling sum of complex128s.
 type ComplexSummer128 struct {
 	realSum *Summer64
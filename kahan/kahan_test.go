@@ -0,0 +1,39 @@
+package kahan
+
+import "testing"
+
+func TestSummer64AddScaledCancellation(t *testing.T) {
+	s := NewSummer64()
+	s.AddScaled(1, 1e16)
+	s.AddScaled(1, 1)
+	s.AddScaled(1, -1e16)
+	if got := s.Sum(); got != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+}
+
+func TestSummer64AddAndAddScaledInterleaved(t *testing.T) {
+	s := NewSummer64()
+	s.Add(1e16)
+	s.Add(1)
+	s.AddScaled(1, -1e16)
+	if got := s.Sum(); got != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+}
+
+func TestDot64(t *testing.T) {
+	a := []float64{1e16, 1, -1e16}
+	b := []float64{1, 1, 1}
+	if got := Dot64(a, b); got != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+}
+
+func TestAxpySum64(t *testing.T) {
+	x := []float64{1e16, 1, -1e16}
+	y := []float64{0, 0, 0}
+	if got := AxpySum64(1, x, y); got != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+}
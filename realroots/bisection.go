@@ -37,6 +37,14 @@ func bisectionSteps(i Interval, prec float64) int {
 	return int(math.Ceil(math.Log2(ratio)))
 }
 
+// NewBisector creates a RootFinder that can be driven
+// one Step at a time using bisection.
+func NewBisector(f Func, i Interval) RootFinder {
+	return newBisector(f, i)
+}
+
+var _ RootFinder = (*bisector)(nil)
+
 type bisector struct {
 	interval Interval
 	function Func
@@ -0,0 +1,167 @@
+package realroots
+
+import "math"
+
+// maxBrentSteps bounds the number of iterations Brent
+// will run, guarding against a tol that is unreachable
+// due to floating-point precision.
+const maxBrentSteps = 100
+
+// Brent approximates a real root on a given interval
+// of a continuous function f, provided that the sign
+// of f at i.Start differs from the sign of f at i.End.
+//
+// Brent combines inverse quadratic interpolation and
+// the secant method for fast convergence on smooth
+// functions, falling back to bisection whenever a step
+// would leave the bracketing interval or fails to make
+// adequate progress. This gives it superlinear
+// convergence in the common case while preserving the
+// bracketing guarantee that bisection provides.
+//
+// tol specifies the desired width of the final
+// bracketing interval.
+//
+// If f is exactly zero at either end of the start
+// interval, or at any step during the procedure, then
+// the perfect root will be returned immediately.
+func Brent(f Func, i Interval, tol float64) float64 {
+	b := newBrenter(f, i)
+	for steps := 0; steps < maxBrentSteps && !b.Done() && !b.Bounded(tol); steps++ {
+		b.Step()
+	}
+	return b.Root()
+}
+
+// NewBrent creates a RootFinder that can be driven one
+// Step at a time using Brent's method.
+func NewBrent(f Func, i Interval) RootFinder {
+	return newBrenter(f, i)
+}
+
+var _ RootFinder = (*brenter)(nil)
+
+type brenter struct {
+	f Func
+
+	a, b, c    float64
+	fa, fb, fc float64
+
+	// d is the value of b from two iterations ago,
+	// used to decide whether the bisection fallback
+	// is required.
+	d float64
+
+	mflag bool
+	done  bool
+	root  float64
+}
+
+func newBrenter(f Func, i Interval) *brenter {
+	a, b := i.Start, i.End
+	fa, fb := f.Eval(a), f.Eval(b)
+
+	if fa == 0 {
+		return &brenter{f: f, done: true, root: a}
+	}
+	if fb == 0 {
+		return &brenter{f: f, done: true, root: b}
+	}
+
+	if math.Abs(fa) < math.Abs(fb) {
+		a, b = b, a
+		fa, fb = fb, fa
+	}
+
+	return &brenter{
+		f:     f,
+		a:     a,
+		b:     b,
+		c:     a,
+		fa:    fa,
+		fb:    fb,
+		fc:    fa,
+		d:     a,
+		mflag: true,
+	}
+}
+
+// Step performs one iteration of Brent's method.
+func (b *brenter) Step() {
+	if b.done {
+		return
+	}
+
+	var s float64
+	if b.fa != b.fc && b.fb != b.fc {
+		// Inverse quadratic interpolation.
+		s = b.a*b.fb*b.fc/((b.fa-b.fb)*(b.fa-b.fc)) +
+			b.b*b.fa*b.fc/((b.fb-b.fa)*(b.fb-b.fc)) +
+			b.c*b.fa*b.fb/((b.fc-b.fa)*(b.fc-b.fb))
+	} else {
+		// Secant method.
+		s = b.b - b.fb*(b.b-b.a)/(b.fb-b.fa)
+	}
+
+	lowBound := (3*b.a + b.b) / 4
+	highBound := b.b
+	if lowBound > highBound {
+		lowBound, highBound = highBound, lowBound
+	}
+
+	needsBisection := s < lowBound || s > highBound
+	if b.mflag {
+		needsBisection = needsBisection || math.Abs(s-b.b) >= math.Abs(b.b-b.c)/2
+	} else {
+		needsBisection = needsBisection || math.Abs(s-b.b) >= math.Abs(b.c-b.d)/2
+	}
+
+	if needsBisection {
+		s = (b.a + b.b) / 2
+		b.mflag = true
+	} else {
+		b.mflag = false
+	}
+
+	fs := b.f.Eval(s)
+	if fs == 0 {
+		b.done = true
+		b.root = s
+		return
+	}
+
+	b.d = b.c
+	b.c, b.fc = b.b, b.fb
+
+	if (b.fa > 0) != (fs > 0) {
+		b.b, b.fb = s, fs
+	} else {
+		b.a, b.fa = s, fs
+	}
+
+	if math.Abs(b.fa) < math.Abs(b.fb) {
+		b.a, b.b = b.b, b.a
+		b.fa, b.fb = b.fb, b.fa
+	}
+}
+
+// Done returns true if Brent's method has landed on
+// an exact root.
+func (b *brenter) Done() bool {
+	return b.done
+}
+
+// Root returns the best current approximation of the
+// root.
+func (b *brenter) Root() float64 {
+	if b.done {
+		return b.root
+	}
+	return b.b
+}
+
+// Bounded returns true if the error of the approximate
+// root is no greater than e.
+func (b *brenter) Bounded(e float64) bool {
+	return math.Abs(b.b-b.a) <= e
+}
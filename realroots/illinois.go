@@ -0,0 +1,131 @@
+package realroots
+
+import "math"
+
+// maxIllinoisSteps bounds the number of iterations
+// Illinois will run, guarding against a tol that is
+// unreachable due to floating-point precision.
+const maxIllinoisSteps = 100
+
+// Illinois approximates a real root on a given
+// interval of a continuous function f, provided that
+// the sign of f at i.Start differs from the sign of f
+// at i.End.
+//
+// Illinois is a modification of regula falsi: whenever
+// the same endpoint is retained for two iterations in
+// a row, its function value is halved before computing
+// the next estimate. This prevents the slow, one-sided
+// convergence that plain regula falsi suffers from on
+// functions with significant curvature.
+//
+// tol specifies the desired width of the final
+// bracketing interval.
+//
+// If f is exactly zero at either end of the start
+// interval, or at any step during the procedure, then
+// the perfect root will be returned immediately.
+func Illinois(f Func, i Interval, tol float64) float64 {
+	s := newIllinoisSolver(f, i)
+	for steps := 0; steps < maxIllinoisSteps && !s.Done() && !s.Bounded(tol); steps++ {
+		s.Step()
+	}
+	return s.Root()
+}
+
+// NewIllinois creates a RootFinder that can be driven
+// one Step at a time using the Illinois method.
+func NewIllinois(f Func, i Interval) RootFinder {
+	return newIllinoisSolver(f, i)
+}
+
+var _ RootFinder = (*illinoisSolver)(nil)
+
+type illinoisSolver struct {
+	f Func
+
+	a, b   float64
+	fa, fb float64
+
+	// lastReplaced is 1 if a was replaced on the last
+	// Step, 2 if b was replaced, and 0 before the first
+	// Step.
+	lastReplaced int
+
+	estimate float64
+	done     bool
+	root     float64
+}
+
+func newIllinoisSolver(f Func, i Interval) *illinoisSolver {
+	a, b := i.Start, i.End
+	fa, fb := f.Eval(a), f.Eval(b)
+
+	if fa == 0 {
+		return &illinoisSolver{f: f, done: true, root: a}
+	}
+	if fb == 0 {
+		return &illinoisSolver{f: f, done: true, root: b}
+	}
+
+	return &illinoisSolver{
+		f:        f,
+		a:        a,
+		b:        b,
+		fa:       fa,
+		fb:       fb,
+		estimate: a,
+	}
+}
+
+// Step performs one iteration of the Illinois method.
+func (s *illinoisSolver) Step() {
+	if s.done {
+		return
+	}
+
+	c := (s.a*s.fb - s.b*s.fa) / (s.fb - s.fa)
+	fc := s.f.Eval(c)
+	s.estimate = c
+
+	if fc == 0 {
+		s.done = true
+		s.root = c
+		return
+	}
+
+	if (fc > 0) == (s.fa > 0) {
+		s.a, s.fa = c, fc
+		if s.lastReplaced == 1 {
+			s.fb /= 2
+		}
+		s.lastReplaced = 1
+	} else {
+		s.b, s.fb = c, fc
+		if s.lastReplaced == 2 {
+			s.fa /= 2
+		}
+		s.lastReplaced = 2
+	}
+}
+
+// Done returns true if the Illinois method has landed
+// on an exact root.
+func (s *illinoisSolver) Done() bool {
+	return s.done
+}
+
+// Root returns the best current approximation of the
+// root.
+func (s *illinoisSolver) Root() float64 {
+	if s.done {
+		return s.root
+	}
+	return s.estimate
+}
+
+// Bounded returns true if the error of the approximate
+// root is no greater than e.
+func (s *illinoisSolver) Bounded(e float64) bool {
+	return math.Abs(s.b-s.a) <= e
+}
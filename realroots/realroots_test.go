@@ -0,0 +1,59 @@
+package realroots
+
+import "testing"
+
+type polyFunc func(float64) float64
+
+func (p polyFunc) Eval(x float64) float64 {
+	return p(x)
+}
+
+func TestBrent(t *testing.T) {
+	f := polyFunc(func(x float64) float64 { return x*x*x - x - 2 })
+	root := Brent(f, Interval{Start: 1, End: 2}, 1e-10)
+	if val := f.Eval(root); val > 1e-6 || val < -1e-6 {
+		t.Errorf("bad root %v with f(root)=%v", root, val)
+	}
+}
+
+func TestBrentPerfectRoot(t *testing.T) {
+	f := polyFunc(func(x float64) float64 { return x - 1 })
+	if root := Brent(f, Interval{Start: 0, End: 1}, 1e-10); root != 1 {
+		t.Errorf("expected exact root 1, got %v", root)
+	}
+}
+
+func TestIllinois(t *testing.T) {
+	f := polyFunc(func(x float64) float64 { return x*x - 2 })
+	root := Illinois(f, Interval{Start: 0, End: 2}, 1e-10)
+	if val := f.Eval(root); val > 1e-6 || val < -1e-6 {
+		t.Errorf("bad root %v with f(root)=%v", root, val)
+	}
+}
+
+func TestIllinoisPerfectRoot(t *testing.T) {
+	f := polyFunc(func(x float64) float64 { return x - 1 })
+	if root := Illinois(f, Interval{Start: 0, End: 1}, 1e-10); root != 1 {
+		t.Errorf("expected exact root 1, got %v", root)
+	}
+}
+
+func TestRootFinders(t *testing.T) {
+	f := polyFunc(func(x float64) float64 { return x*x - 2 })
+	interval := Interval{Start: 0, End: 2}
+
+	finders := map[string]RootFinder{
+		"bisection": NewBisector(f, interval),
+		"brent":     NewBrent(f, interval),
+		"illinois":  NewIllinois(f, interval),
+	}
+
+	for name, finder := range finders {
+		for i := 0; i < 60 && !finder.Done() && !finder.Bounded(1e-9); i++ {
+			finder.Step()
+		}
+		if val := f.Eval(finder.Root()); val > 1e-4 || val < -1e-4 {
+			t.Errorf("%s: bad root %v with f(root)=%v", name, finder.Root(), val)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package realroots
+
+// A RootFinder iteratively narrows in on a root of a
+// bracketed function, one Step at a time.
+//
+// Bisection, Brent, and Illinois are all drivable
+// through a RootFinder, so callers can interleave
+// root-finding with other work or stop as soon as a
+// desired precision is reached, rather than running a
+// fixed number of steps up front.
+type RootFinder interface {
+	// Step performs one iteration of the method.
+	Step()
+
+	// Done returns true if the exact root has been
+	// found, making further Steps unnecessary.
+	Done() bool
+
+	// Root returns the best current approximation of
+	// the root.
+	Root() float64
+
+	// Bounded returns true if the error of the
+	// approximate root is no greater than e.
+	Bounded(e float64) bool
+}